@@ -0,0 +1,240 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package oracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/secrets"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/singleflight"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// defaultCacheTTL is used when OracleProvider.CacheTTL is unset.
+	defaultCacheTTL = 30 * time.Second
+	// negativeCacheTTL bounds how long a failed lookup is cached, short enough to recover
+	// quickly from transient errors but long enough to absorb a stampede on a missing key.
+	negativeCacheTTL = 5 * time.Second
+)
+
+var (
+	cacheMetricsOnce sync.Once
+
+	cacheRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "externalsecrets",
+		Subsystem: "provider_oracle",
+		Name:      "secret_cache_requests_total",
+		Help:      "Number of OCI Vault GetSecretBundleByName calls the caching layer served, by result.",
+	}, []string{"result"})
+)
+
+// registerCacheMetrics registers the cache counters with the controller-runtime metrics
+// registry exactly once, regardless of how many caching clients are constructed.
+func registerCacheMetrics() {
+	cacheMetricsOnce.Do(func() {
+		ctrlmetrics.Registry.MustRegister(cacheRequestsTotal)
+	})
+}
+
+type cacheEntry struct {
+	expiresAt time.Time
+	payload   secrets.GetSecretBundleByNameResponse
+	err       error
+}
+
+// cachingVMClient decorates a VMInterface with a TTL cache and single-flight request
+// coalescing, keyed by (vaultID, secretName, stage), to stay under OCI Vaults' per-tenant
+// request limits when many ExternalSecrets reconcile against the same ones.
+type cachingVMClient struct {
+	client VMInterface
+	ttl    time.Duration
+
+	group singleflight.Group
+	mu    sync.Mutex
+	cache map[string]cacheEntry
+
+	callMu   sync.Mutex
+	inFlight map[string]*inFlightCall
+}
+
+// inFlightCall is the context shared by every caller currently coalesced onto the same
+// singleflight key. It's canceled once the last of them stops waiting (either because the
+// call finished, or because it was the only caller left and its own ctx was canceled), but
+// never by a single caller's cancellation while others are still waiting on the same result.
+type inFlightCall struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	waiters int
+}
+
+// newCachingVMClient wraps client with a TTL cache. A non-positive ttl disables caching
+// entirely and returns client unwrapped.
+func newCachingVMClient(client VMInterface, ttl time.Duration) VMInterface {
+	if ttl <= 0 {
+		return client
+	}
+	registerCacheMetrics()
+	return &cachingVMClient{
+		client:   client,
+		ttl:      ttl,
+		cache:    make(map[string]cacheEntry),
+		inFlight: make(map[string]*inFlightCall),
+	}
+}
+
+func secretCacheKey(req secrets.GetSecretBundleByNameRequest) string {
+	var vaultID, name string
+	if req.VaultId != nil {
+		vaultID = *req.VaultId
+	}
+	if req.SecretName != nil {
+		name = *req.SecretName
+	}
+	return fmt.Sprintf("%s|%s|%s", vaultID, name, req.Stage)
+}
+
+func (c *cachingVMClient) GetSecretBundleByName(ctx context.Context, req secrets.GetSecretBundleByNameRequest) (secrets.GetSecretBundleByNameResponse, error) {
+	key := secretCacheKey(req)
+
+	if entry, ok := c.get(key); ok {
+		cacheRequestsTotal.WithLabelValues("hit").Inc()
+		return entry.payload, entry.err
+	}
+
+	// The call below may end up shared across every caller coalesced onto this key, so it
+	// can't simply inherit one caller's cancellation: joinCall gives us a context that's
+	// canceled when ctx is, but only takes effect once every other joined caller has also
+	// left, so a solo caller's cancellation still cancels the underlying call promptly while
+	// a coalesced one doesn't abort the request for the callers still waiting on it.
+	callCtx, leave := c.joinCall(key, ctx)
+	defer leave()
+
+	v, err, shared := c.group.Do(key, func() (interface{}, error) {
+		resp, err := c.client.GetSecretBundleByName(callCtx, req)
+		if err != nil {
+			err = withRequestID(err, resp.OpcRequestId)
+		}
+		c.put(key, resp, err)
+		return resp, err
+	})
+
+	if shared {
+		cacheRequestsTotal.WithLabelValues("coalesced").Inc()
+	} else {
+		cacheRequestsTotal.WithLabelValues("miss").Inc()
+	}
+
+	return v.(secrets.GetSecretBundleByNameResponse), err
+}
+
+// joinCall registers ctx as a waiter on the in-flight call for key, creating one if none is
+// running, and returns the call's shared context plus a leave func the caller must invoke
+// exactly once (via defer) when it's done waiting on the result. The shared context is
+// canceled once the last remaining waiter leaves, whether that's because the call completed
+// normally or because every caller still interested in it had its own ctx canceled.
+func (c *cachingVMClient) joinCall(key string, ctx context.Context) (context.Context, func()) {
+	c.callMu.Lock()
+	call, ok := c.inFlight[key]
+	if !ok {
+		callCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		call = &inFlightCall{ctx: callCtx, cancel: cancel}
+		c.inFlight[key] = call
+	}
+	call.waiters++
+	c.callMu.Unlock()
+
+	var once sync.Once
+	leave := func() {
+		once.Do(func() {
+			c.callMu.Lock()
+			call.waiters--
+			last := call.waiters == 0
+			if last && c.inFlight[key] == call {
+				delete(c.inFlight, key)
+			}
+			c.callMu.Unlock()
+			if last {
+				call.cancel()
+			}
+		})
+	}
+
+	// If ctx is canceled while other callers are still waiting on this key, only this
+	// caller leaves; the shared call keeps running for the rest. If this was the only
+	// caller, leaving cancels the shared context and the underlying call is aborted.
+	stop := context.AfterFunc(ctx, leave)
+	return call.ctx, func() {
+		stop()
+		leave()
+	}
+}
+
+func (c *cachingVMClient) get(key string) (cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.cache[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return cacheEntry{}, false
+	}
+	return entry, true
+}
+
+func (c *cachingVMClient) put(key string, payload secrets.GetSecretBundleByNameResponse, err error) {
+	// A canceled/deadline-exceeded error reflects the detached call context, not a real
+	// Vault-side failure, and caching it would serve that local hiccup to unrelated callers.
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return
+	}
+
+	ttl := c.ttl
+	if err != nil {
+		ttl = negativeCacheTTL
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.evictExpiredLocked()
+	c.cache[key] = cacheEntry{
+		expiresAt: time.Now().Add(ttl),
+		payload:   payload,
+		err:       err,
+	}
+}
+
+// evictExpiredLocked drops expired entries so that keys which stop being requested (a
+// deleted or renamed ExternalSecret) don't linger in the cache indefinitely. Callers must
+// hold c.mu.
+func (c *cachingVMClient) evictExpiredLocked() {
+	now := time.Now()
+	for key, entry := range c.cache {
+		if now.After(entry.expiresAt) {
+			delete(c.cache, key)
+		}
+	}
+}
+
+// withRequestID annotates err with OCI's opc-request-id, if present, so it can be handed to
+// Oracle support when investigating a failed call.
+func withRequestID(err error, opcRequestID *string) error {
+	if opcRequestID == nil || *opcRequestID == "" {
+		return err
+	}
+	return fmt.Errorf("%w (opc-request-id: %s)", err, *opcRequestID)
+}
@@ -0,0 +1,227 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package oracle
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/secrets"
+)
+
+func TestNewCachingVMClientDisabledByNonPositiveTTL(t *testing.T) {
+	client := &fakeVMClient{}
+	got := newCachingVMClient(client, 0)
+	if got != VMInterface(client) {
+		t.Error("newCachingVMClient(client, 0) should return the client unwrapped")
+	}
+}
+
+func TestCachingVMClientCoalescesConcurrentCallsForSameKey(t *testing.T) {
+	vault, name := "vault-1", "my-secret"
+	var inFlight sync.WaitGroup
+	inFlight.Add(1)
+	release := make(chan struct{})
+
+	vm := &fakeVMClient{
+		getFn: func(_ context.Context, req secrets.GetSecretBundleByNameRequest) (secrets.GetSecretBundleByNameResponse, error) {
+			inFlight.Done()
+			<-release
+			content := base64.StdEncoding.EncodeToString([]byte("hunter2"))
+			return secrets.GetSecretBundleByNameResponse{
+				SecretBundle: secrets.SecretBundle{
+					SecretBundleContent: secrets.Base64SecretBundleContentDetails{Content: &content},
+				},
+			}, nil
+		},
+	}
+	cached := newCachingVMClient(vm, time.Minute).(*cachingVMClient)
+
+	const concurrentCallers = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, concurrentCallers)
+	for i := 0; i < concurrentCallers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := cached.GetSecretBundleByName(context.Background(), secrets.GetSecretBundleByNameRequest{VaultId: &vault, SecretName: &name})
+			errs <- err
+		}()
+	}
+
+	inFlight.Wait()
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("GetSecretBundleByName() error = %v, want nil", err)
+		}
+	}
+
+	vm.mu.Lock()
+	calls := vm.calls
+	vm.mu.Unlock()
+	if calls != 1 {
+		t.Errorf("underlying GetSecretBundleByName calls = %d, want 1 (all callers should coalesce onto a single request)", calls)
+	}
+}
+
+func TestCachingVMClientServesSecondCallFromCache(t *testing.T) {
+	vault, name := "vault-1", "my-secret"
+	vm := &fakeVMClient{}
+	cached := newCachingVMClient(vm, time.Minute)
+
+	for i := 0; i < 2; i++ {
+		if _, err := cached.GetSecretBundleByName(context.Background(), secrets.GetSecretBundleByNameRequest{VaultId: &vault, SecretName: &name}); err != nil {
+			t.Fatalf("GetSecretBundleByName() error = %v, want nil", err)
+		}
+	}
+
+	vm.mu.Lock()
+	calls := vm.calls
+	vm.mu.Unlock()
+	if calls != 1 {
+		t.Errorf("underlying GetSecretBundleByName calls = %d, want 1 (second call should hit the cache)", calls)
+	}
+}
+
+func TestCachingVMClientPropagatesSoloCallerCancellation(t *testing.T) {
+	vault, name := "vault-1", "my-secret"
+	started := make(chan struct{})
+	vm := &fakeVMClient{
+		getFn: func(ctx context.Context, _ secrets.GetSecretBundleByNameRequest) (secrets.GetSecretBundleByNameResponse, error) {
+			close(started)
+			<-ctx.Done()
+			return secrets.GetSecretBundleByNameResponse{}, ctx.Err()
+		},
+	}
+	cached := newCachingVMClient(vm, time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := cached.GetSecretBundleByName(ctx, secrets.GetSecretBundleByNameRequest{VaultId: &vault, SecretName: &name})
+		done <- err
+	}()
+
+	<-started
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("GetSecretBundleByName() error = %v, want context.Canceled", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("GetSecretBundleByName() did not return after its sole caller's context was canceled")
+	}
+}
+
+func TestCachingVMClientDoesNotAbortCoalescedCallOnOneWaiterCancellation(t *testing.T) {
+	vault, name := "vault-1", "my-secret"
+	var inFlight sync.WaitGroup
+	inFlight.Add(2)
+	release := make(chan struct{})
+
+	vm := &fakeVMClient{
+		getFn: func(ctx context.Context, _ secrets.GetSecretBundleByNameRequest) (secrets.GetSecretBundleByNameResponse, error) {
+			inFlight.Done()
+			select {
+			case <-ctx.Done():
+				return secrets.GetSecretBundleByNameResponse{}, ctx.Err()
+			case <-release:
+			}
+			content := base64.StdEncoding.EncodeToString([]byte("hunter2"))
+			return secrets.GetSecretBundleByNameResponse{
+				SecretBundle: secrets.SecretBundle{
+					SecretBundleContent: secrets.Base64SecretBundleContentDetails{Content: &content},
+				},
+			}, nil
+		},
+	}
+	cached := newCachingVMClient(vm, time.Minute)
+	req := secrets.GetSecretBundleByNameRequest{VaultId: &vault, SecretName: &name}
+
+	// Two callers coalesce onto the same underlying request; one of them cancels its own
+	// ctx while the other keeps waiting. Since both Do() calls block on the same shared
+	// call regardless, neither returns until release fires -- what this test guards is
+	// that canceling isn't enough, on its own, to abort the request out from under the
+	// caller that's still waiting on it.
+	cancelCtx, cancel := context.WithCancel(context.Background())
+	cancelDone := make(chan error, 1)
+	go func() {
+		_, err := cached.GetSecretBundleByName(cancelCtx, req)
+		cancelDone <- err
+	}()
+
+	staying := make(chan error, 1)
+	go func() {
+		_, err := cached.GetSecretBundleByName(context.Background(), req)
+		staying <- err
+	}()
+
+	inFlight.Wait()
+	cancel()
+
+	// Give the canceled waiter's leave() a moment to run; since the other waiter is still
+	// around, that must not cancel the shared call.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+
+	if err := <-cancelDone; err != nil {
+		t.Errorf("canceled waiter's GetSecretBundleByName() error = %v, want nil (the shared call still completed successfully)", err)
+	}
+	if err := <-staying; err != nil {
+		t.Errorf("remaining waiter's GetSecretBundleByName() error = %v, want nil", err)
+	}
+
+	vm.mu.Lock()
+	calls := vm.calls
+	vm.mu.Unlock()
+	if calls != 1 {
+		t.Errorf("underlying GetSecretBundleByName calls = %d, want 1 (one waiter's cancellation must not abort and retry the shared call)", calls)
+	}
+}
+
+func TestCachingVMClientDoesNotCacheCanceledContextErrors(t *testing.T) {
+	vault, name := "vault-1", "my-secret"
+	vm := &fakeVMClient{
+		getFn: func(_ context.Context, _ secrets.GetSecretBundleByNameRequest) (secrets.GetSecretBundleByNameResponse, error) {
+			return secrets.GetSecretBundleByNameResponse{}, context.Canceled
+		},
+	}
+	cached := newCachingVMClient(vm, time.Minute)
+
+	if _, err := cached.GetSecretBundleByName(context.Background(), secrets.GetSecretBundleByNameRequest{VaultId: &vault, SecretName: &name}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("GetSecretBundleByName() error = %v, want context.Canceled", err)
+	}
+
+	vm.getFn = func(_ context.Context, req secrets.GetSecretBundleByNameRequest) (secrets.GetSecretBundleByNameResponse, error) {
+		content := base64.StdEncoding.EncodeToString([]byte("hunter2"))
+		return secrets.GetSecretBundleByNameResponse{
+			SecretBundle: secrets.SecretBundle{
+				SecretBundleContent: secrets.Base64SecretBundleContentDetails{Content: &content},
+			},
+		}, nil
+	}
+	if _, err := cached.GetSecretBundleByName(context.Background(), secrets.GetSecretBundleByNameRequest{VaultId: &vault, SecretName: &name}); err != nil {
+		t.Fatalf("GetSecretBundleByName() error = %v, want nil (a canceled-context error must not have been cached)", err)
+	}
+}
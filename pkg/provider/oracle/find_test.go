@@ -0,0 +1,189 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package oracle
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sync"
+	"testing"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/oracle/oci-go-sdk/v65/secrets"
+	"github.com/oracle/oci-go-sdk/v65/vaults"
+)
+
+// fakeVMClient is a minimal, thread-safe VMInterface for unit tests.
+type fakeVMClient struct {
+	mu    sync.Mutex
+	calls int
+
+	getFn func(ctx context.Context, req secrets.GetSecretBundleByNameRequest) (secrets.GetSecretBundleByNameResponse, error)
+}
+
+func (f *fakeVMClient) GetSecretBundleByName(ctx context.Context, req secrets.GetSecretBundleByNameRequest) (secrets.GetSecretBundleByNameResponse, error) {
+	f.mu.Lock()
+	f.calls++
+	f.mu.Unlock()
+	if f.getFn != nil {
+		return f.getFn(ctx, req)
+	}
+	content := base64.StdEncoding.EncodeToString([]byte(*req.SecretName))
+	return secrets.GetSecretBundleByNameResponse{
+		SecretBundle: secrets.SecretBundle{
+			SecretBundleContent: secrets.Base64SecretBundleContentDetails{Content: &content},
+		},
+	}, nil
+}
+
+func TestListMatchingSecretNamesPagesAndFiltersByNameAndLifecycleState(t *testing.T) {
+	pageOneNext := "page-2"
+	client := &fakeVaultsClient{
+		listSecretsFn: func(_ context.Context, req vaults.ListSecretsRequest) (vaults.ListSecretsResponse, error) {
+			if req.Page == nil {
+				return vaults.ListSecretsResponse{
+					Items: []vaults.SecretSummary{
+						secretSummary("db-password", "ocid1.vaultsecret.oc1..1"),
+						secretSummary("db-username", "ocid1.vaultsecret.oc1..2"),
+					},
+					OpcNextPage: &pageOneNext,
+				}, nil
+			}
+			deleting := secretSummary("db-old", "ocid1.vaultsecret.oc1..3")
+			deleting.LifecycleState = vaults.SecretSummaryLifecycleStateSchedulingDeletion
+			return vaults.ListSecretsResponse{
+				Items: []vaults.SecretSummary{
+					secretSummary("api-key", "ocid1.vaultsecret.oc1..4"),
+					deleting,
+				},
+			}, nil
+		},
+	}
+	vms := &VaultManagementService{VaultsClient: client, vault: "vault-1", compartment: "compartment-1"}
+
+	names, err := vms.listMatchingSecretNames(context.Background(), nil, nil, nil)
+	if err != nil {
+		t.Fatalf("listMatchingSecretNames() error = %v, want nil", err)
+	}
+
+	want := map[string]bool{"db-password": true, "db-username": true, "api-key": true}
+	if len(names) != len(want) {
+		t.Fatalf("listMatchingSecretNames() = %v, want %v", names, want)
+	}
+	for _, n := range names {
+		if !want[n] {
+			t.Errorf("unexpected name %q in result, or pending-deletion secret %q was not filtered out", n, "db-old")
+		}
+	}
+}
+
+func TestGetAllSecretsAppliesNameRegExp(t *testing.T) {
+	client := &fakeVaultsClient{
+		listSecretsFn: func(_ context.Context, _ vaults.ListSecretsRequest) (vaults.ListSecretsResponse, error) {
+			return vaults.ListSecretsResponse{
+				Items: []vaults.SecretSummary{
+					secretSummary("db-password", "ocid1.vaultsecret.oc1..1"),
+					secretSummary("api-key", "ocid1.vaultsecret.oc1..2"),
+				},
+			}, nil
+		},
+	}
+	vm := &fakeVMClient{}
+	vms := &VaultManagementService{VaultsClient: client, Client: vm, vault: "vault-1", compartment: "compartment-1"}
+
+	got, err := vms.GetAllSecrets(context.Background(), esv1beta1.ExternalSecretFind{
+		Name: &esv1beta1.FindName{RegExp: "^db-"},
+	})
+	if err != nil {
+		t.Fatalf("GetAllSecrets() error = %v, want nil", err)
+	}
+	if _, ok := got["db-password"]; !ok {
+		t.Errorf("GetAllSecrets() = %v, want it to contain db-password", got)
+	}
+	if _, ok := got["api-key"]; ok {
+		t.Errorf("GetAllSecrets() = %v, want api-key filtered out by the RegExp", got)
+	}
+}
+
+func TestGetAllSecretsPassesTagsToListSecretsAsFreeformFilter(t *testing.T) {
+	var gotFreeformTags map[string]string
+	var gotDefinedTags map[string]map[string]interface{}
+	client := &fakeVaultsClient{
+		listSecretsFn: func(_ context.Context, req vaults.ListSecretsRequest) (vaults.ListSecretsResponse, error) {
+			gotFreeformTags = req.FreeformTags
+			gotDefinedTags = req.DefinedTags
+			return vaults.ListSecretsResponse{
+				Items: []vaults.SecretSummary{secretSummary("db-password", "ocid1.vaultsecret.oc1..1")},
+			}, nil
+		},
+	}
+	vm := &fakeVMClient{}
+	vms := &VaultManagementService{VaultsClient: client, Client: vm, vault: "vault-1", compartment: "compartment-1"}
+
+	_, err := vms.GetAllSecrets(context.Background(), esv1beta1.ExternalSecretFind{
+		Tags: map[string]string{"env": "prod"},
+	})
+	if err != nil {
+		t.Fatalf("GetAllSecrets() error = %v, want nil", err)
+	}
+
+	if gotFreeformTags["env"] != "prod" {
+		t.Errorf("ListSecretsRequest.FreeformTags = %v, want {env: prod} pushed to the server as an exact-match filter", gotFreeformTags)
+	}
+	if gotDefinedTags != nil {
+		t.Errorf("ListSecretsRequest.DefinedTags = %v, want nil (find.Tags can't express OCI defined tags)", gotDefinedTags)
+	}
+}
+
+func TestGetAllSecretsRejectsInvalidRegExp(t *testing.T) {
+	vms := &VaultManagementService{VaultsClient: &fakeVaultsClient{}, vault: "vault-1", compartment: "compartment-1"}
+
+	_, err := vms.GetAllSecrets(context.Background(), esv1beta1.ExternalSecretFind{
+		Name: &esv1beta1.FindName{RegExp: "("},
+	})
+	if err == nil {
+		t.Fatal("GetAllSecrets() error = nil, want error for invalid RegExp")
+	}
+}
+
+func TestFetchSecretsByNameReturnsErrorWithoutLeakingWorkers(t *testing.T) {
+	wantErr := fmt.Errorf("boom")
+	names := make([]string, 0, getAllSecretsConcurrency*4)
+	for i := 0; i < cap(names); i++ {
+		names = append(names, fmt.Sprintf("secret-%d", i))
+	}
+
+	vm := &fakeVMClient{
+		getFn: func(_ context.Context, req secrets.GetSecretBundleByNameRequest) (secrets.GetSecretBundleByNameResponse, error) {
+			if *req.SecretName == names[0] {
+				return secrets.GetSecretBundleByNameResponse{}, wantErr
+			}
+			content := base64.StdEncoding.EncodeToString([]byte(*req.SecretName))
+			return secrets.GetSecretBundleByNameResponse{
+				SecretBundle: secrets.SecretBundle{
+					SecretBundleContent: secrets.Base64SecretBundleContentDetails{Content: &content},
+				},
+			}, nil
+		},
+	}
+	vms := &VaultManagementService{Client: vm}
+
+	// Run many times under -race: if a worker ever blocked sending into an unread results
+	// channel, this would hang instead of returning promptly.
+	_, err := vms.fetchSecretsByName(context.Background(), names)
+	if err == nil {
+		t.Fatal("fetchSecretsByName() error = nil, want error")
+	}
+}
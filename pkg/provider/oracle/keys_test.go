@@ -0,0 +1,129 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package oracle
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/certificates"
+	"github.com/oracle/oci-go-sdk/v65/keymanagement"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+func TestSplitObjectRef(t *testing.T) {
+	cases := []struct {
+		key            string
+		wantType       string
+		wantIdentifier string
+	}{
+		{"my-secret", objectTypeSecret, "my-secret"},
+		{"secret/my-secret", objectTypeSecret, "my-secret"},
+		{"key/ocid1.key.oc1..abc", objectTypeKey, "ocid1.key.oc1..abc"},
+		{"cert/ocid1.certificate.oc1..abc", objectTypeCert, "ocid1.certificate.oc1..abc"},
+		{"unknownprefix/value", objectTypeSecret, "unknownprefix/value"},
+	}
+
+	for _, tc := range cases {
+		gotType, gotID := splitObjectRef(tc.key)
+		if gotType != tc.wantType || gotID != tc.wantIdentifier {
+			t.Errorf("splitObjectRef(%q) = (%q, %q), want (%q, %q)", tc.key, gotType, gotID, tc.wantType, tc.wantIdentifier)
+		}
+	}
+}
+
+// fakeKmsMgmtClient is a minimal KmsMgmtVCInterface for unit tests.
+type fakeKmsMgmtClient struct {
+	currentKeyVersion string
+	publicKeyPEM      string
+}
+
+func (f *fakeKmsMgmtClient) GetKey(_ context.Context, _ keymanagement.GetKeyRequest) (keymanagement.GetKeyResponse, error) {
+	v := f.currentKeyVersion
+	return keymanagement.GetKeyResponse{Key: keymanagement.Key{CurrentKeyVersion: &v}}, nil
+}
+
+func (f *fakeKmsMgmtClient) GetKeyVersion(_ context.Context, _ keymanagement.GetKeyVersionRequest) (keymanagement.GetKeyVersionResponse, error) {
+	pem := f.publicKeyPEM
+	return keymanagement.GetKeyVersionResponse{KeyVersion: keymanagement.KeyVersion{PublicKey: &pem}}, nil
+}
+
+func TestGetSecretDispatchesKeyPrefixToKmsMgmtClient(t *testing.T) {
+	kms := &fakeKmsMgmtClient{currentKeyVersion: "v1", publicKeyPEM: "-----BEGIN PUBLIC KEY-----\n...\n-----END PUBLIC KEY-----"}
+	vms := &VaultManagementService{KmsMgmtClient: kms}
+
+	got, err := vms.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "key/ocid1.key.oc1..abc"})
+	if err != nil {
+		t.Fatalf("GetSecret() error = %v, want nil", err)
+	}
+	if string(got) != kms.publicKeyPEM {
+		t.Errorf("GetSecret() = %q, want %q", got, kms.publicKeyPEM)
+	}
+}
+
+// fakeCertificatesClient is a minimal CertificatesVCInterface for unit tests.
+type fakeCertificatesClient struct {
+	certificatePEM string
+}
+
+func (f *fakeCertificatesClient) GetCertificateBundle(_ context.Context, _ certificates.GetCertificateBundleRequest) (certificates.GetCertificateBundleResponse, error) {
+	pem := f.certificatePEM
+	return certificates.GetCertificateBundleResponse{
+		CertificateBundle: certificates.CertificateBundleWithPublicKey{CertificatePem: &pem},
+	}, nil
+}
+
+func TestGetSecretDispatchesCertPrefixToCertificatesClient(t *testing.T) {
+	certs := &fakeCertificatesClient{certificatePEM: "-----BEGIN CERTIFICATE-----\n...\n-----END CERTIFICATE-----"}
+	vms := &VaultManagementService{CertificatesClient: certs}
+
+	got, err := vms.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "cert/ocid1.certificate.oc1..abc"})
+	if err != nil {
+		t.Fatalf("GetSecret() error = %v, want nil", err)
+	}
+	if string(got) != certs.certificatePEM {
+		t.Errorf("GetSecret() = %q, want %q", got, certs.certificatePEM)
+	}
+}
+
+func TestKmsManagementClientIsBuiltLazily(t *testing.T) {
+	built := false
+	vms := &VaultManagementService{
+		newKmsMgmtClient: func(_ context.Context) (KmsMgmtVCInterface, error) {
+			built = true
+			return &fakeKmsMgmtClient{currentKeyVersion: "v1", publicKeyPEM: "pem"}, nil
+		},
+	}
+
+	if built {
+		t.Fatal("newKmsMgmtClient ran before any key/-prefixed lookup")
+	}
+
+	if _, err := vms.getKeyMaterial(context.Background(), "ocid1.key.oc1..abc"); err != nil {
+		t.Fatalf("getKeyMaterial() error = %v, want nil", err)
+	}
+	if !built {
+		t.Error("newKmsMgmtClient was never invoked on first key/ lookup")
+	}
+
+	// A second lookup must reuse the cached client rather than rebuilding it.
+	built = false
+	if _, err := vms.getKeyMaterial(context.Background(), "ocid1.key.oc1..abc"); err != nil {
+		t.Fatalf("getKeyMaterial() error = %v, want nil", err)
+	}
+	if built {
+		t.Error("newKmsMgmtClient ran again instead of reusing the cached client")
+	}
+}
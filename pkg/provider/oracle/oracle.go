@@ -19,13 +19,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"regexp"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/oracle/oci-go-sdk/v65/certificates"
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/common/auth"
 	"github.com/oracle/oci-go-sdk/v65/keymanagement"
 	"github.com/oracle/oci-go-sdk/v65/secrets"
+	"github.com/oracle/oci-go-sdk/v65/vaults"
 	"github.com/tidwall/gjson"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -53,6 +57,25 @@ const (
 	errJSONSecretUnmarshal                   = "unable to unmarshal secret: %w"
 	errMissingKey                            = "missing Key in secret: %s"
 	errUnexpectedContent                     = "unexpected secret bundle content"
+	errMissingCompartment                    = "missing Compartment"
+	errListSecrets                           = "unable to list secrets: %w"
+	errCreateSecret                          = "unable to create secret: %w"
+	errUpdateSecret                          = "unable to update secret: %w"
+	errScheduleSecretDeletion                = "unable to schedule secret deletion: %w"
+	errGetKey                                = "unable to get key: %w"
+	errGetKeyVersion                         = "unable to get key version: %w"
+	errMissingPublicKey                      = "key version does not contain a public key"
+	errGetCertificateBundle                  = "unable to get certificate bundle: %w"
+	errUnexpectedCertificateBundle           = "unexpected certificate bundle content"
+	errInvalidRegexp                         = "invalid Name.RegExp: %w"
+	errMissingConfigFileSecret               = "missing ConfigFileSecretRef"
+	errMissingConfigFileProfile              = "missing ConfigFileProfile"
+	errWriteConfigFile                       = "unable to write OCI config file: %w"
+	errNoChainedPrincipal                    = "no configuration provider in the chain could be constructed"
+
+	objectTypeSecret = "secret"
+	objectTypeKey    = "key"
+	objectTypeCert   = "cert"
 )
 
 // https://github.com/external-secrets/external-secrets/issues/644
@@ -60,43 +83,334 @@ var _ esv1beta1.SecretsClient = &VaultManagementService{}
 var _ esv1beta1.Provider = &VaultManagementService{}
 
 type VaultManagementService struct {
-	Client                VMInterface
-	KmsVaultClient        KmsVCInterface
-	vault                 string
-	workloadIdentityMutex sync.Mutex
+	Client             VMInterface
+	VaultsClient       VaultsClientInterface
+	KmsVaultClient     KmsVCInterface
+	KmsMgmtClient      KmsMgmtVCInterface
+	CertificatesClient CertificatesVCInterface
+	vault              string
+	compartment        string
+	encryptionKey      string
+
+	// newKmsMgmtClient lazily builds KmsMgmtClient on first use: constructing it requires a
+	// GetVault call that not every SecretStore principal is authorized to make, so it must
+	// not run for stores that never resolve a `key/`-prefixed reference. See kmsManagementClient.
+	newKmsMgmtClient func(ctx context.Context) (KmsMgmtVCInterface, error)
+	kmsMgmtMu        sync.Mutex
 }
 
 type VMInterface interface {
 	GetSecretBundleByName(ctx context.Context, request secrets.GetSecretBundleByNameRequest) (secrets.GetSecretBundleByNameResponse, error)
 }
 
+// VaultsClientInterface wraps the OCI Vaults control-plane calls needed to push and delete secrets.
+type VaultsClientInterface interface {
+	ListSecrets(ctx context.Context, request vaults.ListSecretsRequest) (vaults.ListSecretsResponse, error)
+	CreateSecret(ctx context.Context, request vaults.CreateSecretRequest) (vaults.CreateSecretResponse, error)
+	UpdateSecret(ctx context.Context, request vaults.UpdateSecretRequest) (vaults.UpdateSecretResponse, error)
+	ScheduleSecretDeletion(ctx context.Context, request vaults.ScheduleSecretDeletionRequest) (vaults.ScheduleSecretDeletionResponse, error)
+}
+
 type KmsVCInterface interface {
 	GetVault(ctx context.Context, request keymanagement.GetVaultRequest) (response keymanagement.GetVaultResponse, err error)
 }
 
-// Not Implemented PushSecret.
-func (vms *VaultManagementService) PushSecret(_ context.Context, _ []byte, _ *apiextensionsv1.JSON, _ esv1beta1.PushRemoteRef) error {
-	return fmt.Errorf("not implemented")
+// KmsMgmtVCInterface wraps the KMS management-plane calls needed to project key material.
+type KmsMgmtVCInterface interface {
+	GetKey(ctx context.Context, request keymanagement.GetKeyRequest) (keymanagement.GetKeyResponse, error)
+	GetKeyVersion(ctx context.Context, request keymanagement.GetKeyVersionRequest) (keymanagement.GetKeyVersionResponse, error)
+}
+
+// CertificatesVCInterface wraps the OCI Certificates Service calls needed to project certificate bundles.
+type CertificatesVCInterface interface {
+	GetCertificateBundle(ctx context.Context, request certificates.GetCertificateBundleRequest) (certificates.GetCertificateBundleResponse, error)
+}
+
+// findSecretByName looks up the (at most one) non-deleted secret with the given name in the
+// configured vault/compartment, returning nil if none exists yet.
+func (vms *VaultManagementService) findSecretByName(ctx context.Context, name string) (*vaults.SecretSummary, error) {
+	req := vaults.ListSecretsRequest{
+		CompartmentId: &vms.compartment,
+		VaultId:       &vms.vault,
+		Name:          &name,
+	}
+	resp, err := vms.VaultsClient.ListSecrets(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf(errListSecrets, err)
+	}
+	for i := range resp.Items {
+		item := resp.Items[i]
+		if item.LifecycleState == vaults.SecretSummaryLifecycleStatePendingDeletion ||
+			item.LifecycleState == vaults.SecretSummaryLifecycleStateSchedulingDeletion {
+			continue
+		}
+		return &item, nil
+	}
+	return nil, nil
+}
+
+// PushSecret creates a new OCI Vault secret or, if one with the given name already exists,
+// schedules a new version of it with the provided content.
+func (vms *VaultManagementService) PushSecret(ctx context.Context, value []byte, _ *apiextensionsv1.JSON, remoteRef esv1beta1.PushRemoteRef) error {
+	if utils.IsNil(vms.VaultsClient) {
+		return fmt.Errorf(errUninitalizedOracleProvider)
+	}
+	if vms.compartment == "" {
+		return fmt.Errorf(errMissingCompartment)
+	}
+
+	name := remoteRef.RemoteKey()
+	content := base64.StdEncoding.EncodeToString(value)
+
+	existing, err := vms.findSecretByName(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	contentDetails := vaults.Base64SecretContentDetails{
+		Content: &content,
+	}
+
+	if existing == nil {
+		details := vaults.CreateSecretDetails{
+			CompartmentId: &vms.compartment,
+			SecretName:    &name,
+			VaultId:       &vms.vault,
+			SecretContent: contentDetails,
+			KeyId:         vms.keyID(),
+		}
+		_, err := vms.VaultsClient.CreateSecret(ctx, vaults.CreateSecretRequest{CreateSecretDetails: details})
+		if err != nil {
+			return fmt.Errorf(errCreateSecret, err)
+		}
+		return nil
+	}
+
+	_, err = vms.VaultsClient.UpdateSecret(ctx, vaults.UpdateSecretRequest{
+		SecretId: existing.Id,
+		UpdateSecretDetails: vaults.UpdateSecretDetails{
+			SecretContent: contentDetails,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf(errUpdateSecret, err)
+	}
+	return nil
+}
+
+// keyID returns a pointer to the configured KMS encryption key, or nil if none was set,
+// letting OCI Vault fall back to the vault's default key.
+func (vms *VaultManagementService) keyID() *string {
+	if vms.encryptionKey == "" {
+		return nil
+	}
+	return &vms.encryptionKey
+}
+
+// DeleteSecret schedules deletion of the OCI Vault secret matching the given remote key.
+// It is a no-op if no such secret exists.
+func (vms *VaultManagementService) DeleteSecret(ctx context.Context, remoteRef esv1beta1.PushRemoteRef) error {
+	if utils.IsNil(vms.VaultsClient) {
+		return fmt.Errorf(errUninitalizedOracleProvider)
+	}
+
+	existing, err := vms.findSecretByName(ctx, remoteRef.RemoteKey())
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return nil
+	}
+
+	_, err = vms.VaultsClient.ScheduleSecretDeletion(ctx, vaults.ScheduleSecretDeletionRequest{
+		SecretId:                      existing.Id,
+		ScheduleSecretDeletionDetails: vaults.ScheduleSecretDeletionDetails{},
+	})
+	if err != nil {
+		return fmt.Errorf(errScheduleSecretDeletion, err)
+	}
+	return nil
+}
+
+// getAllSecretsConcurrency bounds the number of concurrent GetSecretBundleByName calls
+// GetAllSecrets issues while hydrating the secrets matched by ListSecrets.
+const getAllSecretsConcurrency = 5
+
+// GetAllSecrets lists the secrets in the configured vault/compartment matching find.Name.RegExp
+// and/or find.Tags (treated as an exact-match freeform tag filter), then fetches the content
+// of each match.
+func (vms *VaultManagementService) GetAllSecrets(ctx context.Context, find esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	if utils.IsNil(vms.VaultsClient) {
+		return nil, fmt.Errorf(errUninitalizedOracleProvider)
+	}
+
+	var nameMatcher *regexp.Regexp
+	if find.Name != nil && find.Name.RegExp != "" {
+		var err error
+		nameMatcher, err = regexp.Compile(find.Name.RegExp)
+		if err != nil {
+			return nil, fmt.Errorf(errInvalidRegexp, err)
+		}
+	}
+
+	freeformTags, definedTags := splitFindTags(find.Tags)
+
+	names, err := vms.listMatchingSecretNames(ctx, nameMatcher, freeformTags, definedTags)
+	if err != nil {
+		return nil, err
+	}
+
+	return vms.fetchSecretsByName(ctx, names)
+}
+
+// listMatchingSecretNames pages through ListSecrets, pushing freeformTags to the server as
+// an exact-match filter and post-filtering names client-side against nameMatcher (the API has
+// no server-side regexp support).
+func (vms *VaultManagementService) listMatchingSecretNames(ctx context.Context, nameMatcher *regexp.Regexp, freeformTags map[string]string, definedTags map[string]map[string]interface{}) ([]string, error) {
+	var names []string
+	var page *string
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		resp, err := vms.VaultsClient.ListSecrets(ctx, vaults.ListSecretsRequest{
+			CompartmentId: &vms.compartment,
+			VaultId:       &vms.vault,
+			FreeformTags:  freeformTags,
+			DefinedTags:   definedTags,
+			Page:          page,
+		})
+		if err != nil {
+			return nil, fmt.Errorf(errListSecrets, err)
+		}
+
+		for i := range resp.Items {
+			item := resp.Items[i]
+			if item.LifecycleState == vaults.SecretSummaryLifecycleStatePendingDeletion ||
+				item.LifecycleState == vaults.SecretSummaryLifecycleStateSchedulingDeletion {
+				continue
+			}
+			if nameMatcher != nil && (item.SecretName == nil || !nameMatcher.MatchString(*item.SecretName)) {
+				continue
+			}
+			if item.SecretName != nil {
+				names = append(names, *item.SecretName)
+			}
+		}
+
+		if resp.OpcNextPage == nil {
+			return names, nil
+		}
+		page = resp.OpcNextPage
+	}
 }
 
-func (vms *VaultManagementService) DeleteSecret(_ context.Context, _ esv1beta1.PushRemoteRef) error {
-	return fmt.Errorf("not implemented")
+// splitFindTags turns the provider-agnostic ExternalSecretFind.Tags map into the
+// FreeformTags the OCI Vaults ListSecrets API accepts as an exact-match server-side filter.
+// OCI defined tags (namespace-scoped, keyed by a separate namespace field) aren't
+// expressible from a flat string map at all, so find.Tags is only ever treated as freeform
+// tags; definedTags is always nil.
+func splitFindTags(tags map[string]string) (freeformTags map[string]string, definedTags map[string]map[string]interface{}) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	return tags, nil
 }
 
-// Empty GetAllSecrets.
-func (vms *VaultManagementService) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
-	// TO be implemented
-	return nil, fmt.Errorf("GetAllSecrets not implemented")
+// fetchSecretsByName hydrates each named secret's content using a bounded worker pool so a
+// large match set doesn't fan out unbounded requests against OCI's per-tenant rate limits.
+func (vms *VaultManagementService) fetchSecretsByName(ctx context.Context, names []string) (map[string][]byte, error) {
+	type result struct {
+		name    string
+		payload []byte
+		err     error
+	}
+
+	work := make(chan string)
+	// Buffered to len(names) so that a worker's send never blocks, even if the consumer loop
+	// below returns early on the first error — without this, any in-flight workers would
+	// leak forever trying to send into a results channel nobody is reading anymore.
+	results := make(chan result, len(names))
+
+	var wg sync.WaitGroup
+	for i := 0; i < getAllSecretsConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for name := range work {
+				payload, err := vms.getSecretBundle(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: name}, name)
+				results <- result{name: name, payload: payload, err: err}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(work)
+		for _, name := range names {
+			select {
+			case work <- name:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	secretData := make(map[string][]byte, len(names))
+	for res := range results {
+		if res.err != nil {
+			return nil, res.err
+		}
+		secretData[res.name] = res.payload
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return secretData, nil
+}
+
+// splitObjectRef splits a ref.Key of the form "<type>/<id>" into its object type and
+// identifier. Keys without a recognized "secret/", "key/" or "cert/" prefix are treated as
+// plain secret names, for backwards compatibility with existing ExternalSecrets.
+func splitObjectRef(key string) (objectType, id string) {
+	if prefix, rest, ok := strings.Cut(key, "/"); ok {
+		switch prefix {
+		case objectTypeSecret, objectTypeKey, objectTypeCert:
+			return prefix, rest
+		}
+	}
+	return objectTypeSecret, key
 }
 
 func (vms *VaultManagementService) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	objectType, id := splitObjectRef(ref.Key)
+
+	switch objectType {
+	case objectTypeKey:
+		return vms.getKeyMaterial(ctx, id)
+	case objectTypeCert:
+		return vms.getCertificate(ctx, id)
+	default:
+		return vms.getSecretBundle(ctx, ref, id)
+	}
+}
+
+func (vms *VaultManagementService) getSecretBundle(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef, secretName string) ([]byte, error) {
 	if utils.IsNil(vms.Client) {
 		return nil, fmt.Errorf(errUninitalizedOracleProvider)
 	}
 
 	sec, err := vms.Client.GetSecretBundleByName(ctx, secrets.GetSecretBundleByNameRequest{
 		VaultId:    &vms.vault,
-		SecretName: &ref.Key,
+		SecretName: &secretName,
 		Stage:      secrets.GetSecretBundleByNameStageEnum(ref.Version),
 	})
 	if err != nil {
@@ -126,6 +440,82 @@ func (vms *VaultManagementService) GetSecret(ctx context.Context, ref esv1beta1.
 	return []byte(val.String()), nil
 }
 
+// getKeyMaterial returns the PEM-encoded public key of a KMS asymmetric key, fetching its
+// current key version when keyID does not reference a version explicitly.
+func (vms *VaultManagementService) getKeyMaterial(ctx context.Context, keyID string) ([]byte, error) {
+	client, err := vms.kmsManagementClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := client.GetKey(ctx, keymanagement.GetKeyRequest{KeyId: &keyID})
+	if err != nil {
+		return nil, fmt.Errorf(errGetKey, err)
+	}
+
+	version, err := client.GetKeyVersion(ctx, keymanagement.GetKeyVersionRequest{
+		KeyId:        &keyID,
+		KeyVersionId: key.CurrentKeyVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf(errGetKeyVersion, err)
+	}
+
+	if version.PublicKey == nil {
+		return nil, fmt.Errorf(errMissingPublicKey)
+	}
+
+	return []byte(*version.PublicKey), nil
+}
+
+// kmsManagementClient returns the KMS management client, building and caching it on first
+// use if the caller (or a test) hasn't already set KmsMgmtClient directly.
+func (vms *VaultManagementService) kmsManagementClient(ctx context.Context) (KmsMgmtVCInterface, error) {
+	vms.kmsMgmtMu.Lock()
+	defer vms.kmsMgmtMu.Unlock()
+
+	if !utils.IsNil(vms.KmsMgmtClient) {
+		return vms.KmsMgmtClient, nil
+	}
+	if vms.newKmsMgmtClient == nil {
+		return nil, fmt.Errorf(errUninitalizedOracleProvider)
+	}
+
+	client, err := vms.newKmsMgmtClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	vms.KmsMgmtClient = client
+	return client, nil
+}
+
+// getCertificate returns the PEM-encoded leaf certificate of an OCI Certificates Service
+// certificate bundle.
+func (vms *VaultManagementService) getCertificate(ctx context.Context, certificateID string) ([]byte, error) {
+	if utils.IsNil(vms.CertificatesClient) {
+		return nil, fmt.Errorf(errUninitalizedOracleProvider)
+	}
+
+	bundle, err := vms.CertificatesClient.GetCertificateBundle(ctx, certificates.GetCertificateBundleRequest{
+		CertificateId: &certificateID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf(errGetCertificateBundle, err)
+	}
+
+	cb, ok := bundle.CertificateBundle.(certificates.CertificateBundleWithPrivateKey)
+	if ok {
+		return []byte(*cb.CertificatePem), nil
+	}
+
+	basic, ok := bundle.CertificateBundle.(certificates.CertificateBundleWithPublicKey)
+	if !ok {
+		return nil, fmt.Errorf(errUnexpectedCertificateBundle)
+	}
+
+	return []byte(*basic.CertificatePem), nil
+}
+
 func (vms *VaultManagementService) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
 	data, err := vms.GetSecret(ctx, ref)
 	if err != nil {
@@ -145,7 +535,7 @@ func (vms *VaultManagementService) GetSecretMap(ctx context.Context, ref esv1bet
 
 // Capabilities return the provider supported capabilities (ReadOnly, WriteOnly, ReadWrite).
 func (vms *VaultManagementService) Capabilities() esv1beta1.SecretStoreCapabilities {
-	return esv1beta1.SecretStoreReadOnly
+	return esv1beta1.SecretStoreReadWrite
 }
 
 // NewClient constructs a new secrets client based on the provided store.
@@ -161,36 +551,7 @@ func (vms *VaultManagementService) NewClient(ctx context.Context, store esv1beta
 		return nil, fmt.Errorf(errMissingRegion)
 	}
 
-	var (
-		err                   error
-		configurationProvider common.ConfigurationProvider
-	)
-
-	if oracleSpec.PrincipalType == esv1beta1.WorkloadPrincipal {
-		defer vms.workloadIdentityMutex.Unlock()
-		vms.workloadIdentityMutex.Lock()
-		// OCI SDK requires specific environment variables for workload identity.
-		if err := os.Setenv(auth.ResourcePrincipalVersionEnvVar, auth.ResourcePrincipalVersion2_2); err != nil {
-			return nil, fmt.Errorf("unable to set OCI SDK environment variable %s: %w", auth.ResourcePrincipalVersionEnvVar, err)
-		}
-		if err := os.Setenv(auth.ResourcePrincipalRegionEnvVar, oracleSpec.Region); err != nil {
-			return nil, fmt.Errorf("unable to set OCI SDK environment variable %s: %w", auth.ResourcePrincipalRegionEnvVar, err)
-		}
-		configurationProvider, err = auth.OkeWorkloadIdentityConfigurationProvider()
-		if err := os.Unsetenv(auth.ResourcePrincipalVersionEnvVar); err != nil {
-			return nil, fmt.Errorf("unabled to unset OCI SDK environment variable %s: %w", auth.ResourcePrincipalVersionEnvVar, err)
-		}
-		if err := os.Unsetenv(auth.ResourcePrincipalRegionEnvVar); err != nil {
-			return nil, fmt.Errorf("unabled to unset OCI SDK environment variable %s: %w", auth.ResourcePrincipalRegionEnvVar, err)
-		}
-		if err != nil {
-			return nil, err
-		}
-	} else if oracleSpec.PrincipalType == esv1beta1.InstancePrincipal || oracleSpec.Auth == nil {
-		configurationProvider, err = auth.InstancePrincipalConfigurationProvider()
-	} else {
-		configurationProvider, err = getUserAuthConfigurationProvider(ctx, kube, oracleSpec, namespace, store.GetObjectKind().GroupVersionKind().Kind, oracleSpec.Region)
-	}
+	configurationProvider, err := configurationProviderFor(ctx, kube, store, oracleSpec, namespace)
 	if err != nil {
 		return nil, fmt.Errorf(errOracleClient, err)
 	}
@@ -209,6 +570,22 @@ func (vms *VaultManagementService) NewClient(ctx context.Context, store esv1beta
 
 	kmsVaultClient.SetRegion(oracleSpec.Region)
 
+	certificatesClient, err := certificates.NewCertificatesClientWithConfigurationProvider(configurationProvider)
+	if err != nil {
+		return nil, fmt.Errorf(errOracleClient, err)
+	}
+
+	certificatesClient.SetRegion(oracleSpec.Region)
+
+	vaultsClient, err := vaults.NewVaultsClientWithConfigurationProvider(configurationProvider)
+	if err != nil {
+		return nil, fmt.Errorf(errOracleClient, err)
+	}
+
+	vaultsClient.SetRegion(oracleSpec.Region)
+
+	var customRetryPolicy *common.RetryPolicy
+
 	if storeSpec.RetrySettings != nil {
 		opts := []common.RetryPolicyOption{common.WithShouldRetryOperation(common.DefaultShouldRetryOperation)}
 
@@ -224,24 +601,70 @@ func (vms *VaultManagementService) NewClient(ctx context.Context, store esv1beta
 			opts = append(opts, common.WithFixedBackoff(i))
 		}
 
-		customRetryPolicy := common.NewRetryPolicyWithOptions(opts...)
+		policy := common.NewRetryPolicyWithOptions(opts...)
+		customRetryPolicy = &policy
 
 		secretManagementService.SetCustomClientConfiguration(common.CustomClientConfiguration{
-			RetryPolicy: &customRetryPolicy,
+			RetryPolicy: customRetryPolicy,
 		})
 
 		kmsVaultClient.SetCustomClientConfiguration(common.CustomClientConfiguration{
-			RetryPolicy: &customRetryPolicy,
+			RetryPolicy: customRetryPolicy,
 		})
+
+		vaultsClient.SetCustomClientConfiguration(common.CustomClientConfiguration{
+			RetryPolicy: customRetryPolicy,
+		})
+	}
+
+	cacheTTL := defaultCacheTTL
+	if oracleSpec.CacheTTL != nil {
+		cacheTTL, err = time.ParseDuration(*oracleSpec.CacheTTL)
+		if err != nil {
+			return nil, fmt.Errorf(errOracleClient, err)
+		}
 	}
 
 	return &VaultManagementService{
-		Client:         secretManagementService,
-		KmsVaultClient: kmsVaultClient,
-		vault:          oracleSpec.Vault,
+		Client:             newCachingVMClient(secretManagementService, cacheTTL),
+		VaultsClient:       vaultsClient,
+		KmsVaultClient:     kmsVaultClient,
+		CertificatesClient: certificatesClient,
+		vault:              oracleSpec.Vault,
+		compartment:        oracleSpec.Compartment,
+		encryptionKey:      oracleSpec.EncryptionKey,
+		newKmsMgmtClient: func(ctx context.Context) (KmsMgmtVCInterface, error) {
+			return newKmsManagementClient(ctx, kmsVaultClient, configurationProvider, oracleSpec.Vault, oracleSpec.Region, customRetryPolicy)
+		},
 	}, nil
 }
 
+// newKmsManagementClient builds the vault-specific KMS management client on demand: it needs
+// the vault's ManagementEndpoint, which requires a GetVault call that not every principal is
+// authorized to make (read-only secret access doesn't imply vault read access), so this must
+// stay lazy rather than run for every NewClient call.
+func newKmsManagementClient(ctx context.Context, kmsVaultClient KmsVCInterface, configurationProvider common.ConfigurationProvider, vault, region string, retryPolicy *common.RetryPolicy) (KmsMgmtVCInterface, error) {
+	vaultDetails, err := kmsVaultClient.GetVault(ctx, keymanagement.GetVaultRequest{VaultId: &vault})
+	if err != nil {
+		return nil, fmt.Errorf(errOracleClient, err)
+	}
+
+	client, err := keymanagement.NewKmsManagementClientWithConfigurationProvider(configurationProvider, *vaultDetails.ManagementEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf(errOracleClient, err)
+	}
+
+	client.SetRegion(region)
+
+	if retryPolicy != nil {
+		client.SetCustomClientConfiguration(common.CustomClientConfiguration{
+			RetryPolicy: retryPolicy,
+		})
+	}
+
+	return client, nil
+}
+
 func getSecretData(ctx context.Context, kube kclient.Client, namespace, storeKind string, secretRef esmeta.SecretKeySelector) (string, error) {
 	if secretRef.Name == "" {
 		return "", fmt.Errorf(errORACLECredSecretName)
@@ -297,6 +720,127 @@ func getUserAuthConfigurationProvider(ctx context.Context, kube kclient.Client,
 	return common.NewRawConfigurationProvider(store.Auth.Tenancy, store.Auth.User, region, fingerprint, privateKey, nil), nil
 }
 
+// workloadIdentityProviders caches one OkeWorkloadIdentityConfigurationProvider per region.
+// Building a provider requires briefly mutating process-wide OCI SDK environment variables,
+// which isn't safe to do concurrently across SecretStores in different regions; caching the
+// result means that dance only ever happens once per region instead of once per reconcile.
+var (
+	workloadIdentityMu        sync.Mutex
+	workloadIdentityProviders = map[string]common.ConfigurationProvider{}
+
+	// okeWorkloadIdentityProvider is a var indirection over auth.OkeWorkloadIdentityConfigurationProvider
+	// so tests can substitute a fake without making real instance-metadata calls.
+	okeWorkloadIdentityProvider = auth.OkeWorkloadIdentityConfigurationProvider
+)
+
+func getWorkloadIdentityConfigurationProvider(region string) (common.ConfigurationProvider, error) {
+	workloadIdentityMu.Lock()
+	defer workloadIdentityMu.Unlock()
+
+	if cp, ok := workloadIdentityProviders[region]; ok {
+		return cp, nil
+	}
+
+	// OCI SDK requires specific environment variables for workload identity.
+	if err := os.Setenv(auth.ResourcePrincipalVersionEnvVar, auth.ResourcePrincipalVersion2_2); err != nil {
+		return nil, fmt.Errorf("unable to set OCI SDK environment variable %s: %w", auth.ResourcePrincipalVersionEnvVar, err)
+	}
+	if err := os.Setenv(auth.ResourcePrincipalRegionEnvVar, region); err != nil {
+		return nil, fmt.Errorf("unable to set OCI SDK environment variable %s: %w", auth.ResourcePrincipalRegionEnvVar, err)
+	}
+	cp, err := okeWorkloadIdentityProvider()
+	if unsetErr := os.Unsetenv(auth.ResourcePrincipalVersionEnvVar); unsetErr != nil {
+		return nil, fmt.Errorf("unabled to unset OCI SDK environment variable %s: %w", auth.ResourcePrincipalVersionEnvVar, unsetErr)
+	}
+	if unsetErr := os.Unsetenv(auth.ResourcePrincipalRegionEnvVar); unsetErr != nil {
+		return nil, fmt.Errorf("unabled to unset OCI SDK environment variable %s: %w", auth.ResourcePrincipalRegionEnvVar, unsetErr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	workloadIdentityProviders[region] = cp
+	return cp, nil
+}
+
+// getConfigFileConfigurationProvider materializes the OCI config file referenced by
+// store.ConfigFileSecretRef into a temporary file and loads the requested profile from it.
+func getConfigFileConfigurationProvider(ctx context.Context, kube kclient.Client, store *esv1beta1.OracleProvider, namespace, storeKind string) (common.ConfigurationProvider, error) {
+	if store.ConfigFileSecretRef == nil {
+		return nil, fmt.Errorf(errMissingConfigFileSecret)
+	}
+	if store.ConfigFileProfile == "" {
+		return nil, fmt.Errorf(errMissingConfigFileProfile)
+	}
+
+	contents, err := getSecretData(ctx, kube, namespace, storeKind, *store.ConfigFileSecretRef)
+	if err != nil {
+		return nil, err
+	}
+	if contents == "" {
+		return nil, fmt.Errorf(errMissingConfigFileSecret)
+	}
+
+	f, err := os.CreateTemp("", "oci-config-*")
+	if err != nil {
+		return nil, fmt.Errorf(errWriteConfigFile, err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if _, err := f.WriteString(contents); err != nil {
+		return nil, fmt.Errorf(errWriteConfigFile, err)
+	}
+
+	return common.ConfigurationProviderFromFileWithProfile(f.Name(), store.ConfigFileProfile, "")
+}
+
+// getChainedConfigurationProvider composes the user, instance-principal and workload-identity
+// providers in that priority order, using the first one that can be constructed successfully.
+func getChainedConfigurationProvider(ctx context.Context, kube kclient.Client, store *esv1beta1.OracleProvider, namespace, storeKind, region string) (common.ConfigurationProvider, error) {
+	var providers []common.ConfigurationProvider
+
+	if store.Auth != nil {
+		if cp, err := getUserAuthConfigurationProvider(ctx, kube, store, namespace, storeKind, region); err == nil {
+			providers = append(providers, cp)
+		}
+	}
+	if cp, err := auth.InstancePrincipalConfigurationProvider(); err == nil {
+		providers = append(providers, cp)
+	}
+	if cp, err := getWorkloadIdentityConfigurationProvider(region); err == nil {
+		providers = append(providers, cp)
+	}
+
+	if len(providers) == 0 {
+		return nil, fmt.Errorf(errNoChainedPrincipal)
+	}
+
+	return common.ComposingConfigurationProvider(providers)
+}
+
+// configurationProviderFor builds the OCI ConfigurationProvider matching the SecretStore's
+// configured PrincipalType.
+func configurationProviderFor(ctx context.Context, kube kclient.Client, store esv1beta1.GenericStore, oracleSpec *esv1beta1.OracleProvider, namespace string) (common.ConfigurationProvider, error) {
+	storeKind := store.GetObjectKind().GroupVersionKind().Kind
+
+	switch oracleSpec.PrincipalType {
+	case esv1beta1.WorkloadPrincipal:
+		return getWorkloadIdentityConfigurationProvider(oracleSpec.Region)
+	case esv1beta1.ConfigFile:
+		return getConfigFileConfigurationProvider(ctx, kube, oracleSpec, namespace, storeKind)
+	case esv1beta1.ChainedPrincipal:
+		return getChainedConfigurationProvider(ctx, kube, oracleSpec, namespace, storeKind, oracleSpec.Region)
+	case esv1beta1.InstancePrincipal:
+		return auth.InstancePrincipalConfigurationProvider()
+	default:
+		if oracleSpec.Auth == nil {
+			return auth.InstancePrincipalConfigurationProvider()
+		}
+		return getUserAuthConfigurationProvider(ctx, kube, oracleSpec, namespace, storeKind, oracleSpec.Region)
+	}
+}
+
 func (vms *VaultManagementService) Close(_ context.Context) error {
 	return nil
 }
@@ -350,6 +894,18 @@ func (vms *VaultManagementService) ValidateStore(store esv1beta1.GenericStore) e
 		return fmt.Errorf("region cannot be empty")
 	}
 
+	if oracleSpec.PrincipalType == esv1beta1.ConfigFile {
+		if oracleSpec.ConfigFileSecretRef == nil {
+			return fmt.Errorf(errMissingConfigFileSecret)
+		}
+		if oracleSpec.ConfigFileProfile == "" {
+			return fmt.Errorf(errMissingConfigFileProfile)
+		}
+		return utils.ValidateSecretSelector(store, *oracleSpec.ConfigFileSecretRef)
+	}
+
+	// ChainedPrincipal composes user auth with instance-principal/workload-identity, so user
+	// auth below is validated when present but isn't required the way it is for UserPrincipal.
 	auth := oracleSpec.Auth
 	if auth == nil {
 		return nil
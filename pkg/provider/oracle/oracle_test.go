@@ -0,0 +1,172 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package oracle
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/vaults"
+)
+
+// fakeVaultsClient is a minimal, configurable VaultsClientInterface for unit tests.
+type fakeVaultsClient struct {
+	listSecretsFn            func(ctx context.Context, req vaults.ListSecretsRequest) (vaults.ListSecretsResponse, error)
+	createSecretFn           func(ctx context.Context, req vaults.CreateSecretRequest) (vaults.CreateSecretResponse, error)
+	updateSecretFn           func(ctx context.Context, req vaults.UpdateSecretRequest) (vaults.UpdateSecretResponse, error)
+	scheduleSecretDeletionFn func(ctx context.Context, req vaults.ScheduleSecretDeletionRequest) (vaults.ScheduleSecretDeletionResponse, error)
+
+	createCalls int
+	updateCalls int
+	deleteCalls int
+}
+
+func (f *fakeVaultsClient) ListSecrets(ctx context.Context, req vaults.ListSecretsRequest) (vaults.ListSecretsResponse, error) {
+	if f.listSecretsFn != nil {
+		return f.listSecretsFn(ctx, req)
+	}
+	return vaults.ListSecretsResponse{}, nil
+}
+
+func (f *fakeVaultsClient) CreateSecret(ctx context.Context, req vaults.CreateSecretRequest) (vaults.CreateSecretResponse, error) {
+	f.createCalls++
+	if f.createSecretFn != nil {
+		return f.createSecretFn(ctx, req)
+	}
+	return vaults.CreateSecretResponse{}, nil
+}
+
+func (f *fakeVaultsClient) UpdateSecret(ctx context.Context, req vaults.UpdateSecretRequest) (vaults.UpdateSecretResponse, error) {
+	f.updateCalls++
+	if f.updateSecretFn != nil {
+		return f.updateSecretFn(ctx, req)
+	}
+	return vaults.UpdateSecretResponse{}, nil
+}
+
+func (f *fakeVaultsClient) ScheduleSecretDeletion(ctx context.Context, req vaults.ScheduleSecretDeletionRequest) (vaults.ScheduleSecretDeletionResponse, error) {
+	f.deleteCalls++
+	if f.scheduleSecretDeletionFn != nil {
+		return f.scheduleSecretDeletionFn(ctx, req)
+	}
+	return vaults.ScheduleSecretDeletionResponse{}, nil
+}
+
+// fakePushRemoteRef is a minimal esv1beta1.PushRemoteRef for unit tests.
+type fakePushRemoteRef struct {
+	key string
+}
+
+func (f fakePushRemoteRef) RemoteKey() string { return f.key }
+
+func secretSummary(name, id string) vaults.SecretSummary {
+	return vaults.SecretSummary{
+		Id:             &id,
+		SecretName:     &name,
+		LifecycleState: vaults.SecretSummaryLifecycleStateActive,
+	}
+}
+
+func TestPushSecretCreatesWhenMissing(t *testing.T) {
+	client := &fakeVaultsClient{
+		listSecretsFn: func(_ context.Context, _ vaults.ListSecretsRequest) (vaults.ListSecretsResponse, error) {
+			return vaults.ListSecretsResponse{}, nil
+		},
+	}
+	vms := &VaultManagementService{VaultsClient: client, vault: "vault-1", compartment: "compartment-1"}
+
+	err := vms.PushSecret(context.Background(), []byte("hunter2"), nil, fakePushRemoteRef{key: "my-secret"})
+	if err != nil {
+		t.Fatalf("PushSecret() error = %v, want nil", err)
+	}
+	if client.createCalls != 1 {
+		t.Errorf("createCalls = %d, want 1", client.createCalls)
+	}
+	if client.updateCalls != 0 {
+		t.Errorf("updateCalls = %d, want 0", client.updateCalls)
+	}
+}
+
+func TestPushSecretUpdatesWhenPresent(t *testing.T) {
+	client := &fakeVaultsClient{
+		listSecretsFn: func(_ context.Context, _ vaults.ListSecretsRequest) (vaults.ListSecretsResponse, error) {
+			return vaults.ListSecretsResponse{Items: []vaults.SecretSummary{secretSummary("my-secret", "ocid1.vaultsecret.oc1..existing")}}, nil
+		},
+	}
+	vms := &VaultManagementService{VaultsClient: client, vault: "vault-1", compartment: "compartment-1"}
+
+	err := vms.PushSecret(context.Background(), []byte("hunter2"), nil, fakePushRemoteRef{key: "my-secret"})
+	if err != nil {
+		t.Fatalf("PushSecret() error = %v, want nil", err)
+	}
+	if client.updateCalls != 1 {
+		t.Errorf("updateCalls = %d, want 1", client.updateCalls)
+	}
+	if client.createCalls != 0 {
+		t.Errorf("createCalls = %d, want 0", client.createCalls)
+	}
+}
+
+func TestPushSecretRequiresCompartment(t *testing.T) {
+	vms := &VaultManagementService{VaultsClient: &fakeVaultsClient{}, vault: "vault-1"}
+
+	err := vms.PushSecret(context.Background(), []byte("hunter2"), nil, fakePushRemoteRef{key: "my-secret"})
+	if err == nil {
+		t.Fatal("PushSecret() error = nil, want error for missing compartment")
+	}
+}
+
+func TestDeleteSecretSchedulesDeletionWhenPresent(t *testing.T) {
+	client := &fakeVaultsClient{
+		listSecretsFn: func(_ context.Context, _ vaults.ListSecretsRequest) (vaults.ListSecretsResponse, error) {
+			return vaults.ListSecretsResponse{Items: []vaults.SecretSummary{secretSummary("my-secret", "ocid1.vaultsecret.oc1..existing")}}, nil
+		},
+	}
+	vms := &VaultManagementService{VaultsClient: client, vault: "vault-1", compartment: "compartment-1"}
+
+	if err := vms.DeleteSecret(context.Background(), fakePushRemoteRef{key: "my-secret"}); err != nil {
+		t.Fatalf("DeleteSecret() error = %v, want nil", err)
+	}
+	if client.deleteCalls != 1 {
+		t.Errorf("deleteCalls = %d, want 1", client.deleteCalls)
+	}
+}
+
+func TestDeleteSecretNoopWhenMissing(t *testing.T) {
+	client := &fakeVaultsClient{}
+	vms := &VaultManagementService{VaultsClient: client, vault: "vault-1", compartment: "compartment-1"}
+
+	if err := vms.DeleteSecret(context.Background(), fakePushRemoteRef{key: "does-not-exist"}); err != nil {
+		t.Fatalf("DeleteSecret() error = %v, want nil", err)
+	}
+	if client.deleteCalls != 0 {
+		t.Errorf("deleteCalls = %d, want 0", client.deleteCalls)
+	}
+}
+
+func TestDeleteSecretPropagatesListError(t *testing.T) {
+	wantErr := errors.New("boom")
+	client := &fakeVaultsClient{
+		listSecretsFn: func(_ context.Context, _ vaults.ListSecretsRequest) (vaults.ListSecretsResponse, error) {
+			return vaults.ListSecretsResponse{}, wantErr
+		},
+	}
+	vms := &VaultManagementService{VaultsClient: client, vault: "vault-1", compartment: "compartment-1"}
+
+	err := vms.DeleteSecret(context.Background(), fakePushRemoteRef{key: "my-secret"})
+	if err == nil {
+		t.Fatal("DeleteSecret() error = nil, want error")
+	}
+}
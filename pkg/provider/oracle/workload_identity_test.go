@@ -0,0 +1,130 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package oracle
+
+import (
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/common/auth"
+)
+
+// withFakeOkeWorkloadIdentityProvider swaps okeWorkloadIdentityProvider for a fake that
+// captures the env vars visible at call time, restoring the original on cleanup.
+func withFakeOkeWorkloadIdentityProvider(t *testing.T, fn func() (common.ConfigurationProvider, error)) {
+	t.Helper()
+	original := okeWorkloadIdentityProvider
+	okeWorkloadIdentityProvider = fn
+	t.Cleanup(func() { okeWorkloadIdentityProvider = original })
+}
+
+// resetWorkloadIdentityCache clears the package-level provider cache so each test starts
+// from a clean slate regardless of execution order.
+func resetWorkloadIdentityCache(t *testing.T) {
+	t.Helper()
+	workloadIdentityMu.Lock()
+	workloadIdentityProviders = map[string]common.ConfigurationProvider{}
+	workloadIdentityMu.Unlock()
+	t.Cleanup(func() {
+		workloadIdentityMu.Lock()
+		workloadIdentityProviders = map[string]common.ConfigurationProvider{}
+		workloadIdentityMu.Unlock()
+	})
+}
+
+func TestGetWorkloadIdentityConfigurationProviderSetsAndClearsRegionEnvVar(t *testing.T) {
+	resetWorkloadIdentityCache(t)
+
+	var sawRegion string
+	withFakeOkeWorkloadIdentityProvider(t, func() (common.ConfigurationProvider, error) {
+		sawRegion = os.Getenv(auth.ResourcePrincipalRegionEnvVar)
+		return common.NewRawConfigurationProvider("tenancy", "user", "us-phoenix-1", "fingerprint", "key", nil), nil
+	})
+
+	if _, err := getWorkloadIdentityConfigurationProvider("us-phoenix-1"); err != nil {
+		t.Fatalf("getWorkloadIdentityConfigurationProvider() error = %v, want nil", err)
+	}
+
+	if sawRegion != "us-phoenix-1" {
+		t.Errorf("region env var during provider construction = %q, want %q", sawRegion, "us-phoenix-1")
+	}
+	if v := os.Getenv(auth.ResourcePrincipalRegionEnvVar); v != "" {
+		t.Errorf("region env var after construction = %q, want empty (cleared)", v)
+	}
+	if v := os.Getenv(auth.ResourcePrincipalVersionEnvVar); v != "" {
+		t.Errorf("version env var after construction = %q, want empty (cleared)", v)
+	}
+}
+
+func TestGetWorkloadIdentityConfigurationProviderCachesPerRegion(t *testing.T) {
+	resetWorkloadIdentityCache(t)
+
+	var calls int
+	var mu sync.Mutex
+	withFakeOkeWorkloadIdentityProvider(t, func() (common.ConfigurationProvider, error) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		return common.NewRawConfigurationProvider("tenancy", "user", "us-phoenix-1", "fingerprint", "key", nil), nil
+	})
+
+	if _, err := getWorkloadIdentityConfigurationProvider("us-phoenix-1"); err != nil {
+		t.Fatalf("getWorkloadIdentityConfigurationProvider() error = %v, want nil", err)
+	}
+	if _, err := getWorkloadIdentityConfigurationProvider("us-phoenix-1"); err != nil {
+		t.Fatalf("getWorkloadIdentityConfigurationProvider() error = %v, want nil", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("provider construction calls = %d, want 1 (second call should hit the cache)", calls)
+	}
+}
+
+func TestGetWorkloadIdentityConfigurationProviderDoesNotClobberConcurrentRegions(t *testing.T) {
+	resetWorkloadIdentityCache(t)
+
+	regions := []string{"us-phoenix-1", "us-ashburn-1", "eu-frankfurt-1"}
+	seenRegions := make(chan string, len(regions))
+
+	withFakeOkeWorkloadIdentityProvider(t, func() (common.ConfigurationProvider, error) {
+		seenRegions <- os.Getenv(auth.ResourcePrincipalRegionEnvVar)
+		return common.NewRawConfigurationProvider("tenancy", "user", "region", "fingerprint", "key", nil), nil
+	})
+
+	var wg sync.WaitGroup
+	for _, region := range regions {
+		region := region
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := getWorkloadIdentityConfigurationProvider(region); err != nil {
+				t.Errorf("getWorkloadIdentityConfigurationProvider(%q) error = %v, want nil", region, err)
+			}
+		}()
+	}
+	wg.Wait()
+	close(seenRegions)
+
+	got := make(map[string]bool)
+	for r := range seenRegions {
+		got[r] = true
+	}
+	for _, region := range regions {
+		if !got[region] {
+			t.Errorf("region %q was never observed during provider construction, want each of %v observed exactly once", region, regions)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package oracle
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// ServePath is the HTTP path the MutatingWebhookConfiguration in
+	// config/webhook/oracle/manifests.yaml points at.
+	ServePath = "/mutate-oracle-vault-inject"
+
+	errListenAndServe  = "oracle vault injector webhook server stopped: %w"
+	errLoadCertificate = "unable to load TLS certificate %s / %s: %w"
+)
+
+// certReloader re-reads certFile/keyFile from disk whenever their mtime changes, so a
+// cert-manager-rotated certificate takes effect on the next handshake instead of requiring a
+// pod restart.
+type certReloader struct {
+	certFile, keyFile string
+
+	mu          sync.Mutex
+	certModTime time.Time
+	keyModTime  time.Time
+	cert        *tls.Certificate
+}
+
+func (r *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	certInfo, err := os.Stat(r.certFile)
+	if err != nil {
+		return nil, fmt.Errorf(errLoadCertificate, r.certFile, r.keyFile, err)
+	}
+	keyInfo, err := os.Stat(r.keyFile)
+	if err != nil {
+		return nil, fmt.Errorf(errLoadCertificate, r.certFile, r.keyFile, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cert != nil && certInfo.ModTime().Equal(r.certModTime) && keyInfo.ModTime().Equal(r.keyModTime) {
+		return r.cert, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return nil, fmt.Errorf(errLoadCertificate, r.certFile, r.keyFile, err)
+	}
+
+	r.cert = &cert
+	r.certModTime = certInfo.ModTime()
+	r.keyModTime = keyInfo.ModTime()
+	return r.cert, nil
+}
+
+// RunServer serves in behind TLS on addr until ctx is canceled. certFile/keyFile are
+// expected to be kept up to date on disk by cert-manager's Secret-mounting volume
+// projection (see config/webhook/oracle/manifests.yaml); GetCertificate re-stats and, if
+// changed, re-reads them on every handshake so a rotated certificate takes effect without a
+// pod restart.
+func RunServer(ctx context.Context, addr, certFile, keyFile string, in *Injector) error {
+	mux := http.NewServeMux()
+	mux.Handle(ServePath, in)
+
+	reloader := &certReloader{certFile: certFile, keyFile: keyFile}
+	srv := &http.Server{
+		Addr:    addr,
+		Handler: mux,
+		TLSConfig: &tls.Config{
+			GetCertificate: reloader.GetCertificate,
+		},
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.ListenAndServeTLS("", "")
+	}()
+
+	select {
+	case <-ctx.Done():
+		return srv.Shutdown(context.Background())
+	case err := <-errCh:
+		if err != nil && err != http.ErrServerClosed {
+			return fmt.Errorf(errListenAndServe, err)
+		}
+		return nil
+	}
+}
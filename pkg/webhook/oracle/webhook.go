@@ -0,0 +1,337 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package oracle implements a mutating admission webhook that resolves OCI Vault secrets at
+// pod admission time, as an alternative to the ExternalSecret reconciliation loop for
+// secrets that change too often to justify a persistent, explicitly-authored Kubernetes
+// Secret object. Resolved values are written into a Secret generated per pod and wired up
+// via envFrom, rather than inlined into the Pod spec as literal env values, so that reading
+// them still requires `get` on that Secret and not just `get pods`.
+package oracle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	// InjectAnnotation, set to "true" on a pod, opts it into secret injection.
+	InjectAnnotation = "oracle.vault.external-secrets.io/inject"
+	// StoreAnnotation names the (Cluster)SecretStore whose Oracle provider config is used
+	// to resolve secrets for this pod.
+	StoreAnnotation = "oracle.vault.external-secrets.io/store"
+	// StoreNamespaceAnnotation optionally overrides the namespace a matching
+	// ClusterSecretStore resolves its own referenced auth Secrets from; it has no effect
+	// when StoreAnnotation resolves to a namespaced SecretStore, which is always looked up
+	// in (and scoped to) the pod's own namespace.
+	StoreNamespaceAnnotation = "oracle.vault.external-secrets.io/store-namespace"
+	// secretAnnotationPrefix, followed by an env var name, maps that env var to an OCI
+	// Vault secret: oracle.vault.external-secrets.io/secret-FOO: "<vault-secret-name>[#jsonpath]".
+	secretAnnotationPrefix = "oracle.vault.external-secrets.io/secret-"
+	// ownerAnnotation records the pod this generated Secret was injected for, so an operator
+	// (or a future cleanup controller) can tell these Secrets apart from hand-authored ones.
+	ownerAnnotation = "oracle.vault.external-secrets.io/owner-pod"
+	// secretNameSuffix is appended to the pod's own name to derive the generated Secret's
+	// name; admission happens before name-generation for GenerateName pods, so this is only
+	// stable for pods with an explicit Name (see resolveSecretName).
+	secretNameSuffix = "-oracle-env"
+
+	errDecodeReview = "unable to decode AdmissionReview: %w"
+	errDecodePod    = "unable to decode Pod from AdmissionRequest: %w"
+	errMissingStore = "missing required annotation %s"
+	errGetStore     = "unable to get SecretStore %s: %w"
+	errNewClient    = "unable to build Oracle client for SecretStore %s: %w"
+	errGetSecret    = "unable to resolve secret for env var %s: %w"
+	errNoPodName    = "pod has no metadata.name to key the generated env Secret on; set an explicit name instead of relying on generateName"
+	errUpsertSecret = "unable to create or update env Secret %s: %w"
+)
+
+// Injector resolves annotated secret references into pod env vars via an Oracle
+// VaultManagementService built from the referenced SecretStore.
+type Injector struct {
+	Client kclient.Client
+	// NewProvider constructs the provider client for a given store; overridable in tests.
+	NewProvider func(ctx context.Context, store esv1beta1.GenericStore, kube kclient.Client, namespace string) (esv1beta1.SecretsClient, error)
+}
+
+// NewInjector returns an Injector wired to the default Oracle provider.
+func NewInjector(kube kclient.Client, newProvider func(ctx context.Context, store esv1beta1.GenericStore, kube kclient.Client, namespace string) (esv1beta1.SecretsClient, error)) *Injector {
+	return &Injector{Client: kube, NewProvider: newProvider}
+}
+
+// ServeHTTP implements the AdmissionReview webhook protocol for mutating pod creation.
+func (in *Injector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	review := &admissionv1.AdmissionReview{}
+	if err := json.NewDecoder(r.Body).Decode(review); err != nil {
+		http.Error(w, fmt.Sprintf(errDecodeReview, err), http.StatusBadRequest)
+		return
+	}
+
+	review.Response = in.review(r.Context(), review.Request)
+	review.Response.UID = review.Request.UID
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(review)
+}
+
+func (in *Injector) review(ctx context.Context, req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	pod := &corev1.Pod{}
+	if err := json.Unmarshal(req.Object.Raw, pod); err != nil {
+		return deny(fmt.Errorf(errDecodePod, err))
+	}
+
+	if pod.Annotations[InjectAnnotation] != "true" {
+		return allow()
+	}
+
+	// A dry-run admission (e.g. `kubectl apply --dry-run=server`) must not have side
+	// effects: resolving secrets and upserting the backing Secret both do, so skip them and
+	// report no patch rather than mutate the cluster on a request that's only a preview.
+	if req.DryRun != nil && *req.DryRun {
+		return allow()
+	}
+
+	storeName, ok := pod.Annotations[StoreAnnotation]
+	if !ok || storeName == "" {
+		return deny(fmt.Errorf(errMissingStore, StoreAnnotation))
+	}
+
+	client, err := in.clientForStore(ctx, storeName, req.Namespace, pod.Annotations[StoreNamespaceAnnotation])
+	if err != nil {
+		return deny(err)
+	}
+
+	envVars, err := in.resolveEnvVars(ctx, client, pod.Annotations)
+	if err != nil {
+		return deny(err)
+	}
+	if len(envVars) == 0 {
+		return allow()
+	}
+
+	secretName, err := resolveSecretName(pod)
+	if err != nil {
+		return deny(err)
+	}
+	if err := in.upsertEnvSecret(ctx, pod, secretName, envVars); err != nil {
+		return deny(err)
+	}
+
+	patch, err := buildEnvFromPatch(pod, secretName)
+	if err != nil {
+		return deny(err)
+	}
+
+	patchType := admissionv1.PatchTypeJSONPatch
+	return &admissionv1.AdmissionResponse{
+		Allowed:   true,
+		Patch:     patch,
+		PatchType: &patchType,
+	}
+}
+
+// clientForStore looks up storeName as a SecretStore in podNamespace (falling back to a
+// ClusterSecretStore of the same name) and builds an Oracle provider client for it.
+//
+// A namespaced SecretStore is always looked up in podNamespace, never clusterStoreNamespace:
+// that's the tenant boundary SecretStore (as opposed to the cluster-scoped
+// ClusterSecretStore) exists to enforce, and a pod's own annotations -- which its creator
+// controls under ordinary RBAC -- must not be able to widen it to an arbitrary namespace.
+// clusterStoreNamespace only ever selects which namespace a matching ClusterSecretStore
+// resolves its own referenced auth Secrets from, since that store is already cluster-global.
+func (in *Injector) clientForStore(ctx context.Context, storeName, podNamespace, clusterStoreNamespace string) (esv1beta1.SecretsClient, error) {
+	store := &esv1beta1.SecretStore{}
+	key := kclient.ObjectKey{Name: storeName, Namespace: podNamespace}
+	if err := in.Client.Get(ctx, key, store); err != nil {
+		clusterStore := &esv1beta1.ClusterSecretStore{}
+		if err := in.Client.Get(ctx, kclient.ObjectKey{Name: storeName}, clusterStore); err != nil {
+			return nil, fmt.Errorf(errGetStore, storeName, err)
+		}
+		resolveNamespace := podNamespace
+		if clusterStoreNamespace != "" {
+			resolveNamespace = clusterStoreNamespace
+		}
+		c, err := in.NewProvider(ctx, clusterStore, in.Client, resolveNamespace)
+		if err != nil {
+			return nil, fmt.Errorf(errNewClient, storeName, err)
+		}
+		return c, nil
+	}
+
+	c, err := in.NewProvider(ctx, store, in.Client, podNamespace)
+	if err != nil {
+		return nil, fmt.Errorf(errNewClient, storeName, err)
+	}
+	return c, nil
+}
+
+// resolveEnvVars resolves every `secret-<NAME>` annotation into an env var value, fetching
+// the named secret (and optional #jsonpath property) from the Oracle Vault.
+func (in *Injector) resolveEnvVars(ctx context.Context, client esv1beta1.SecretsClient, annotations map[string]string) (map[string]string, error) {
+	envVars := make(map[string]string)
+	for k, v := range annotations {
+		envName, ok := strings.CutPrefix(k, secretAnnotationPrefix)
+		if !ok || envName == "" {
+			continue
+		}
+
+		secretName, property, _ := strings.Cut(v, "#")
+		value, err := client.GetSecret(ctx, esv1beta1.ExternalSecretDataRemoteRef{
+			Key:      secretName,
+			Property: property,
+		})
+		if err != nil {
+			return nil, fmt.Errorf(errGetSecret, envName, err)
+		}
+		envVars[envName] = string(value)
+	}
+	return envVars, nil
+}
+
+type jsonPatchOp struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
+}
+
+// resolveSecretName derives the name of the generated env Secret from the pod's own name.
+// GenerateName-based pods (the common case for Deployments/ReplicaSets) don't have a name
+// assigned yet at admission time, so they're rejected rather than silently handed a
+// collision-prone name; callers that need this to work for GenerateName pods should set an
+// explicit metadata.name on the pod template instead.
+func resolveSecretName(pod *corev1.Pod) (string, error) {
+	if pod.Name == "" {
+		return "", fmt.Errorf(errNoPodName)
+	}
+	return pod.Name + secretNameSuffix, nil
+}
+
+// upsertEnvSecret creates or updates the Secret holding envVars, owned by the Injector so a
+// container only needs `get` on this one Secret rather than on arbitrary ones in its
+// namespace -- injecting values as literal env.value JSONPatch ops would otherwise put the
+// resolved plaintext directly into the Pod object, which is readable by anyone with `get
+// pods`, a much broader RBAC surface than `get` on this specific Secret.
+func (in *Injector) upsertEnvSecret(ctx context.Context, pod *corev1.Pod, name string, envVars map[string]string) error {
+	data := make(map[string][]byte, len(envVars))
+	for k, v := range envVars {
+		data[k] = []byte(v)
+	}
+
+	existing := &corev1.Secret{}
+	err := in.Client.Get(ctx, kclient.ObjectKey{Name: name, Namespace: pod.Namespace}, existing)
+	if apierrors.IsNotFound(err) {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       pod.Namespace,
+				Annotations:     map[string]string{ownerAnnotation: pod.Name},
+				OwnerReferences: podOwnerReference(pod),
+			},
+			Data: data,
+		}
+		if err := in.Client.Create(ctx, secret); err != nil {
+			return fmt.Errorf(errUpsertSecret, name, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf(errUpsertSecret, name, err)
+	}
+
+	existing.Data = data
+	existing.Annotations = map[string]string{ownerAnnotation: pod.Name}
+	existing.OwnerReferences = podOwnerReference(pod)
+	if err := in.Client.Update(ctx, existing); err != nil {
+		return fmt.Errorf(errUpsertSecret, name, err)
+	}
+	return nil
+}
+
+// podOwnerReference returns an OwnerReference binding the generated Secret's lifecycle to
+// pod, so it's garbage-collected when the pod is deleted instead of leaking forever.
+//
+// A CREATE AdmissionRequest's Pod has no UID yet -- the API server assigns it only after
+// mutating admission returns -- so the reference can't be populated on first injection; it's
+// filled in on the next admission review that observes this pod with a UID already set (for
+// example, if the pod is later re-admitted on update). Until then, ownerAnnotation on the
+// Secret is the only way to trace it back to its pod.
+func podOwnerReference(pod *corev1.Pod) []metav1.OwnerReference {
+	if pod.UID == "" {
+		return nil
+	}
+	controller := true
+	blockOwnerDeletion := true
+	return []metav1.OwnerReference{{
+		APIVersion:         "v1",
+		Kind:               "Pod",
+		Name:               pod.Name,
+		UID:                pod.UID,
+		Controller:         &controller,
+		BlockOwnerDeletion: &blockOwnerDeletion,
+	}}
+}
+
+// buildEnvFromPatch points every container in pod at the generated Secret via envFrom, as a
+// JSONPatch document against the admitted pod object. Per RFC 6902, "add" with a trailing
+// "-" index requires the target array to already exist, so a container with no envFrom yet
+// needs a whole-array "add" instead -- almost every pod falls into that case.
+func buildEnvFromPatch(pod *corev1.Pod, secretName string) ([]byte, error) {
+	envFrom := corev1.EnvFromSource{
+		SecretRef: &corev1.SecretEnvSource{
+			LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+		},
+	}
+
+	var ops []jsonPatchOp
+	for i, container := range pod.Spec.Containers {
+		if len(container.EnvFrom) == 0 {
+			ops = append(ops, jsonPatchOp{
+				Op:    "add",
+				Path:  fmt.Sprintf("/spec/containers/%d/envFrom", i),
+				Value: []corev1.EnvFromSource{envFrom},
+			})
+			continue
+		}
+		ops = append(ops, jsonPatchOp{
+			Op:    "add",
+			Path:  fmt.Sprintf("/spec/containers/%d/envFrom/-", i),
+			Value: envFrom,
+		})
+	}
+	return json.Marshal(ops)
+}
+
+func allow() *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{Allowed: true}
+}
+
+func deny(err error) *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{
+		Allowed: false,
+		Result: &metav1.Status{
+			Message: err.Error(),
+		},
+	}
+}
@@ -0,0 +1,312 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package oracle
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+func testScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("corev1.AddToScheme() error = %v", err)
+	}
+	if err := esv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("esv1beta1.AddToScheme() error = %v", err)
+	}
+	return scheme
+}
+
+// fakeSecretsClient is a minimal esv1beta1.SecretsClient for unit tests.
+type fakeSecretsClient struct {
+	getSecretFn func(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error)
+}
+
+func (f *fakeSecretsClient) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	if f.getSecretFn != nil {
+		return f.getSecretFn(ctx, ref)
+	}
+	return []byte(ref.Key), nil
+}
+
+func (f *fakeSecretsClient) GetSecretMap(context.Context, esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	return nil, nil
+}
+
+func (f *fakeSecretsClient) GetAllSecrets(context.Context, esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, nil
+}
+
+func (f *fakeSecretsClient) PushSecret(context.Context, []byte, *apiextensionsv1.JSON, esv1beta1.PushRemoteRef) error {
+	return nil
+}
+
+func (f *fakeSecretsClient) DeleteSecret(context.Context, esv1beta1.PushRemoteRef) error {
+	return nil
+}
+
+func (f *fakeSecretsClient) Validate() (esv1beta1.ValidationResult, error) {
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (f *fakeSecretsClient) Close(context.Context) error {
+	return nil
+}
+
+func newTestPod(namespace, name string, annotations map[string]string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Annotations: annotations,
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "app", Image: "example.com/app:latest"},
+				{Name: "sidecar", Image: "example.com/sidecar:latest", EnvFrom: []corev1.EnvFromSource{
+					{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "existing"}}},
+				}},
+			},
+		},
+	}
+}
+
+func admissionRequestFor(pod *corev1.Pod, namespace string, dryRun bool) *admissionv1.AdmissionRequest {
+	raw, _ := json.Marshal(pod)
+	return &admissionv1.AdmissionRequest{
+		UID:       "req-uid",
+		Namespace: namespace,
+		DryRun:    &dryRun,
+		Object:    runtime.RawExtension{Raw: raw},
+	}
+}
+
+func TestReviewAllowsUnannotatedPodUnchanged(t *testing.T) {
+	in := &Injector{Client: fake.NewClientBuilder().WithScheme(testScheme(t)).Build()}
+	pod := newTestPod("team-a", "my-pod", nil)
+
+	resp := in.review(context.Background(), admissionRequestFor(pod, "team-a", false))
+	if !resp.Allowed {
+		t.Fatalf("review() Allowed = false, want true")
+	}
+	if resp.Patch != nil {
+		t.Errorf("review() Patch = %s, want nil", resp.Patch)
+	}
+}
+
+func TestReviewDeniesMissingStoreAnnotation(t *testing.T) {
+	in := &Injector{Client: fake.NewClientBuilder().WithScheme(testScheme(t)).Build()}
+	pod := newTestPod("team-a", "my-pod", map[string]string{InjectAnnotation: "true"})
+
+	resp := in.review(context.Background(), admissionRequestFor(pod, "team-a", false))
+	if resp.Allowed {
+		t.Fatal("review() Allowed = true, want false for missing store annotation")
+	}
+}
+
+func TestReviewSkipsMutationOnDryRun(t *testing.T) {
+	calledNewProvider := false
+	in := &Injector{
+		Client: fake.NewClientBuilder().WithScheme(testScheme(t)).Build(),
+		NewProvider: func(context.Context, esv1beta1.GenericStore, kclient.Client, string) (esv1beta1.SecretsClient, error) {
+			calledNewProvider = true
+			return &fakeSecretsClient{}, nil
+		},
+	}
+	pod := newTestPod("team-a", "my-pod", map[string]string{
+		InjectAnnotation:               "true",
+		StoreAnnotation:                "my-store",
+		secretAnnotationPrefix + "FOO": "db-password",
+	})
+
+	resp := in.review(context.Background(), admissionRequestFor(pod, "team-a", true))
+	if !resp.Allowed {
+		t.Fatalf("review() Allowed = false, want true")
+	}
+	if resp.Patch != nil {
+		t.Errorf("review() Patch = %s, want nil on dry run", resp.Patch)
+	}
+	if calledNewProvider {
+		t.Error("review() built a provider client on a dry-run request, want no side effects")
+	}
+}
+
+func TestReviewInjectsSecretAndEnvFromPatch(t *testing.T) {
+	store := &esv1beta1.SecretStore{ObjectMeta: metav1.ObjectMeta{Name: "my-store", Namespace: "team-a"}}
+	kube := fake.NewClientBuilder().WithScheme(testScheme(t)).WithObjects(store).Build()
+	in := &Injector{
+		Client: kube,
+		NewProvider: func(context.Context, esv1beta1.GenericStore, kclient.Client, string) (esv1beta1.SecretsClient, error) {
+			return &fakeSecretsClient{getSecretFn: func(_ context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+				return []byte("hunter2"), nil
+			}}, nil
+		},
+	}
+	pod := newTestPod("team-a", "my-pod", map[string]string{
+		InjectAnnotation:               "true",
+		StoreAnnotation:                "my-store",
+		secretAnnotationPrefix + "FOO": "db-password",
+	})
+
+	resp := in.review(context.Background(), admissionRequestFor(pod, "team-a", false))
+	if !resp.Allowed {
+		t.Fatalf("review() Allowed = false, want true (result: %v)", resp.Result)
+	}
+	if resp.Patch == nil {
+		t.Fatal("review() Patch = nil, want a JSONPatch document")
+	}
+
+	secret := &corev1.Secret{}
+	if err := kube.Get(context.Background(), kclient.ObjectKey{Name: "my-pod-oracle-env", Namespace: "team-a"}, secret); err != nil {
+		t.Fatalf("generated Secret not found: %v", err)
+	}
+	if string(secret.Data["FOO"]) != "hunter2" {
+		t.Errorf("Secret data[FOO] = %q, want %q", secret.Data["FOO"], "hunter2")
+	}
+	if secret.Annotations[ownerAnnotation] != "my-pod" {
+		t.Errorf("Secret annotation %s = %q, want %q", ownerAnnotation, secret.Annotations[ownerAnnotation], "my-pod")
+	}
+}
+
+func TestClientForStoreIgnoresNamespaceOverrideForNamespacedStore(t *testing.T) {
+	store := &esv1beta1.SecretStore{ObjectMeta: metav1.ObjectMeta{Name: "my-store", Namespace: "team-a"}}
+	otherNamespaceStore := &esv1beta1.SecretStore{ObjectMeta: metav1.ObjectMeta{Name: "my-store", Namespace: "team-b"}}
+	kube := fake.NewClientBuilder().WithScheme(testScheme(t)).WithObjects(store, otherNamespaceStore).Build()
+
+	var resolvedNamespace string
+	in := &Injector{
+		Client: kube,
+		NewProvider: func(_ context.Context, gs esv1beta1.GenericStore, _ kclient.Client, namespace string) (esv1beta1.SecretsClient, error) {
+			resolvedNamespace = namespace
+			return &fakeSecretsClient{}, nil
+		},
+	}
+
+	client, err := in.clientForStore(context.Background(), "my-store", "team-a", "team-b")
+	if err != nil {
+		t.Fatalf("clientForStore() error = %v, want nil", err)
+	}
+	if client == nil {
+		t.Fatal("clientForStore() client = nil")
+	}
+	if resolvedNamespace != "team-a" {
+		t.Errorf("resolved namespace = %q, want %q (the store-namespace override must not apply to a namespaced SecretStore)", resolvedNamespace, "team-a")
+	}
+}
+
+func TestClientForStoreAppliesNamespaceOverrideForClusterStore(t *testing.T) {
+	clusterStore := &esv1beta1.ClusterSecretStore{ObjectMeta: metav1.ObjectMeta{Name: "my-store"}}
+	kube := fake.NewClientBuilder().WithScheme(testScheme(t)).WithObjects(clusterStore).Build()
+
+	var resolvedNamespace string
+	in := &Injector{
+		Client: kube,
+		NewProvider: func(_ context.Context, gs esv1beta1.GenericStore, _ kclient.Client, namespace string) (esv1beta1.SecretsClient, error) {
+			resolvedNamespace = namespace
+			return &fakeSecretsClient{}, nil
+		},
+	}
+
+	if _, err := in.clientForStore(context.Background(), "my-store", "team-a", "kube-system"); err != nil {
+		t.Fatalf("clientForStore() error = %v, want nil", err)
+	}
+	if resolvedNamespace != "kube-system" {
+		t.Errorf("resolved namespace = %q, want %q (override should apply to a ClusterSecretStore)", resolvedNamespace, "kube-system")
+	}
+}
+
+func TestBuildEnvFromPatchAddsWholeArrayWhenContainerHasNoEnvFrom(t *testing.T) {
+	pod := newTestPod("team-a", "my-pod", nil)
+
+	patchBytes, err := buildEnvFromPatch(pod, "my-pod-oracle-env")
+	if err != nil {
+		t.Fatalf("buildEnvFromPatch() error = %v, want nil", err)
+	}
+
+	var ops []jsonPatchOp
+	if err := json.Unmarshal(patchBytes, &ops); err != nil {
+		t.Fatalf("unmarshal patch: %v", err)
+	}
+	if len(ops) != 2 {
+		t.Fatalf("len(ops) = %d, want 2", len(ops))
+	}
+	if ops[0].Path != "/spec/containers/0/envFrom" {
+		t.Errorf("ops[0].Path = %q, want whole-array add for a container with no envFrom", ops[0].Path)
+	}
+	if ops[1].Path != "/spec/containers/1/envFrom/-" {
+		t.Errorf("ops[1].Path = %q, want append-style add for a container with existing envFrom", ops[1].Path)
+	}
+}
+
+func TestUpsertEnvSecretSetsOwnerReferenceWhenPodHasUID(t *testing.T) {
+	kube := fake.NewClientBuilder().WithScheme(testScheme(t)).Build()
+	in := &Injector{Client: kube}
+	pod := newTestPod("team-a", "my-pod", nil)
+	pod.UID = "pod-uid-123"
+
+	if err := in.upsertEnvSecret(context.Background(), pod, "my-pod-oracle-env", map[string]string{"FOO": "bar"}); err != nil {
+		t.Fatalf("upsertEnvSecret() error = %v, want nil", err)
+	}
+
+	secret := &corev1.Secret{}
+	if err := kube.Get(context.Background(), kclient.ObjectKey{Name: "my-pod-oracle-env", Namespace: "team-a"}, secret); err != nil {
+		t.Fatalf("generated Secret not found: %v", err)
+	}
+	if len(secret.OwnerReferences) != 1 || secret.OwnerReferences[0].UID != pod.UID {
+		t.Errorf("OwnerReferences = %+v, want a single reference to UID %q", secret.OwnerReferences, pod.UID)
+	}
+}
+
+func TestUpsertEnvSecretOmitsOwnerReferenceWhenPodHasNoUIDYet(t *testing.T) {
+	kube := fake.NewClientBuilder().WithScheme(testScheme(t)).Build()
+	in := &Injector{Client: kube}
+	pod := newTestPod("team-a", "my-pod", nil)
+
+	if err := in.upsertEnvSecret(context.Background(), pod, "my-pod-oracle-env", map[string]string{"FOO": "bar"}); err != nil {
+		t.Fatalf("upsertEnvSecret() error = %v, want nil", err)
+	}
+
+	secret := &corev1.Secret{}
+	if err := kube.Get(context.Background(), kclient.ObjectKey{Name: "my-pod-oracle-env", Namespace: "team-a"}, secret); err != nil {
+		t.Fatalf("generated Secret not found: %v", err)
+	}
+	if len(secret.OwnerReferences) != 0 {
+		t.Errorf("OwnerReferences = %+v, want none (pod has no UID at CREATE-time admission)", secret.OwnerReferences)
+	}
+	if secret.Annotations[ownerAnnotation] != "my-pod" {
+		t.Errorf("Secret annotation %s = %q, want %q", ownerAnnotation, secret.Annotations[ownerAnnotation], "my-pod")
+	}
+}
+
+func TestResolveSecretNameRejectsGenerateNamePods(t *testing.T) {
+	pod := newTestPod("team-a", "", nil)
+	pod.GenerateName = "my-deployment-"
+
+	if _, err := resolveSecretName(pod); err == nil {
+		t.Fatal("resolveSecretName() error = nil, want error for a pod with no explicit Name")
+	}
+}